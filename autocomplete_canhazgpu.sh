@@ -76,7 +76,7 @@ _canhazgpu_complete() {
     # Before '--', provide completion for canhazgpu itself
     case "$prev" in
         canhazgpu|chg)
-            COMPREPLY=( $(compgen -W "admin reserve release run status report web help --help --redis-host --redis-port --redis-db" -- "$cur") )
+            COMPREPLY=( $(compgen -W "admin reserve release run status report web help --help --redis-host --redis-port --redis-db --redis-pool-size --redis-dial-timeout --redis-read-timeout --redis-write-timeout --redis-max-retries --redis-tls --redis-tls-ca-cert --redis-tls-cert --redis-tls-key" -- "$cur") )
             ;;
         admin)
             COMPREPLY=( $(compgen -W "--gpus --force --help" -- "$cur") )
@@ -94,7 +94,7 @@ _canhazgpu_complete() {
             COMPREPLY=( $(compgen -W "--json -j --help" -- "$cur") )
             ;;
         report)
-            COMPREPLY=( $(compgen -W "--days --help" -- "$cur") )
+            COMPREPLY=( $(compgen -W "--days --group-by --format --help" -- "$cur") )
             ;;
         web)
             COMPREPLY=( $(compgen -W "--port --host --help" -- "$cur") )
@@ -105,8 +105,14 @@ _canhazgpu_complete() {
         --days)
             COMPREPLY=( $(compgen -W "1 3 7 14 30 60 90" -- "$cur") )
             ;;
+        --group-by)
+            COMPREPLY=( $(compgen -W "user gpu" -- "$cur") )
+            ;;
+        --format)
+            COMPREPLY=( $(compgen -W "table csv" -- "$cur") )
+            ;;
         *)
-            COMPREPLY=( $(compgen -W "admin reserve release run status report web help --help --redis-host --redis-port --redis-db" -- "$cur") )
+            COMPREPLY=( $(compgen -W "admin reserve release run status report web help --help --redis-host --redis-port --redis-db --redis-pool-size --redis-dial-timeout --redis-read-timeout --redis-write-timeout --redis-max-retries --redis-tls --redis-tls-ca-cert --redis-tls-cert --redis-tls-key" -- "$cur") )
             ;;
     esac
 }
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"testing"
+	"time"
 
+	"github.com/russellb/canhazgpu/internal/types"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -108,6 +110,26 @@ func TestRootCommand_Structure(t *testing.T) {
 	redisDBFlag := cmd.PersistentFlags().Lookup("redis-db")
 	require.NotNil(t, redisDBFlag)
 	assert.Equal(t, "int", redisDBFlag.Value.Type())
+
+	// Check Redis connection tuning and TLS flags
+	redisFlags := map[string]string{
+		"redis-pool-size":     "int",
+		"redis-dial-timeout":  "duration",
+		"redis-read-timeout":  "duration",
+		"redis-write-timeout": "duration",
+		"redis-max-retries":   "int",
+		"redis-tls":           "bool",
+		"redis-tls-ca-cert":   "string",
+		"redis-tls-cert":      "string",
+		"redis-tls-key":       "string",
+	}
+	for name, flagType := range redisFlags {
+		flag := cmd.PersistentFlags().Lookup(name)
+		require.NotNil(t, flag, "Global flag %s should exist", name)
+		assert.Equal(t, flagType, flag.Value.Type())
+	}
+	// Pool size defers to go-redis's default of 10 connections per CPU
+	assert.Equal(t, "0", cmd.PersistentFlags().Lookup("redis-pool-size").DefValue)
 }
 
 func TestCommands_NoCompletion(t *testing.T) {
@@ -131,3 +153,78 @@ func TestCommands_HasSubcommands(t *testing.T) {
 	// Verify completion command is NOT present
 	assert.False(t, actualCommands["completion"], "Completion command should be disabled")
 }
+
+func TestInitConfig_RedisEnvironment(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("CANHAZGPU_REDIS_HOST", "redis.example.com")
+	t.Setenv("CANHAZGPU_REDIS_POOL_SIZE", "20")
+	t.Setenv("CANHAZGPU_REDIS_READ_TIMEOUT", "7s")
+	t.Setenv("CANHAZGPU_REDIS_TLS", "true")
+	t.Setenv("CANHAZGPU_REDIS_TLS_CA_CERT", "/etc/canhazgpu/redis-ca.pem")
+	t.Setenv("CANHAZGPU_REDIS_WRITE_TIMEOUT", "0")
+	t.Setenv("CANHAZGPU_REDIS_MAX_RETRIES", "0")
+
+	previous := config
+	t.Cleanup(func() {
+		config = previous
+	})
+
+	initConfig()
+
+	assert.Equal(t, "redis.example.com", config.RedisHost)
+	assert.Equal(t, 20, config.RedisPoolSize)
+	assert.Equal(t, 7*time.Second, config.RedisReadTimeout)
+	assert.True(t, config.RedisTLS)
+	assert.Equal(t, "/etc/canhazgpu/redis-ca.pem", config.RedisTLSCACert)
+
+	// Zero disables retries and timeouts rather than selecting go-redis defaults
+	assert.Equal(t, time.Duration(-1), config.RedisWriteTimeout)
+	assert.Equal(t, -1, config.RedisMaxRetries)
+
+	// Unset values keep their defaults
+	assert.Equal(t, types.DefaultRedisDialTimeout, config.RedisDialTimeout)
+}
+
+func TestValidateRedisSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVar      string
+		value       string
+		errContains string
+	}{
+		{"Default settings", "", "", ""},
+		{"Zero pool size uses default", "CANHAZGPU_REDIS_POOL_SIZE", "0", ""},
+		{"Zero retries disables retries", "CANHAZGPU_REDIS_MAX_RETRIES", "0", ""},
+		{"Zero read timeout disables timeout", "CANHAZGPU_REDIS_READ_TIMEOUT", "0", ""},
+		{"Negative pool size", "CANHAZGPU_REDIS_POOL_SIZE", "-1", "invalid redis pool-size -1"},
+		{"Negative retries", "CANHAZGPU_REDIS_MAX_RETRIES", "-2", "invalid redis max-retries -2"},
+		{"Retries sentinel is not accepted", "CANHAZGPU_REDIS_MAX_RETRIES", "-1", "invalid redis max-retries -1"},
+		{"Negative dial timeout", "CANHAZGPU_REDIS_DIAL_TIMEOUT", "-1s", "invalid redis dial-timeout -1s"},
+		{"Negative read timeout", "CANHAZGPU_REDIS_READ_TIMEOUT", "-5s", "invalid redis read-timeout -5s"},
+		{"Negative write timeout", "CANHAZGPU_REDIS_WRITE_TIMEOUT", "-1ms", "invalid redis write-timeout -1ms"},
+	}
+
+	previous := config
+	t.Cleanup(func() {
+		config = previous
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			if tt.envVar != "" {
+				t.Setenv(tt.envVar, tt.value)
+			}
+
+			initConfig()
+			err := validateRedisSettings()
+
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			}
+		})
+	}
+}
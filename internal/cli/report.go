@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,30 +17,45 @@ import (
 )
 
 var (
-	reportDays int
+	reportDays    int
+	reportGroupBy string
+	reportFormat  string
 )
 
 var reportCmd = &cobra.Command{
 	Use:   "report",
 	Short: "Generate GPU reservation reports",
-	Long:  `Generate reports on GPU reservations over time, showing reservation data by user and aggregate totals.`,
-	RunE:  runReport,
+	Long: `Generate reports on GPU reservations over time, showing reservation data by user and aggregate totals.
+
+Usage can be grouped by user (default) or by GPU, and exported as CSV for
+chargeback or further analysis:
+  canhazgpu report --days 30 --group-by user --format csv > usage.csv`,
+	RunE: runReport,
 }
 
 func init() {
 	reportCmd.Flags().IntVarP(&reportDays, "days", "d", 30, "Number of days to include in the report")
+	reportCmd.Flags().StringVar(&reportGroupBy, "group-by", "user", "Group usage by 'user' or 'gpu'")
+	reportCmd.Flags().StringVar(&reportFormat, "format", "table", "Output format: 'table' or 'csv'")
 	rootCmd.AddCommand(reportCmd)
 }
 
 func runReport(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	if reportGroupBy != "user" && reportGroupBy != "gpu" {
+		return fmt.Errorf("invalid --group-by value %q: must be 'user' or 'gpu'", reportGroupBy)
+	}
+	if reportFormat != "table" && reportFormat != "csv" {
+		return fmt.Errorf("invalid --format value %q: must be 'table' or 'csv'", reportFormat)
+	}
+
 	// Initialize Redis client
 	config := getConfig()
 	client := redis_client.NewClient(config)
 	defer func() {
 		if err := client.Close(); err != nil {
-			fmt.Printf("Warning: failed to close Redis client: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to close Redis client: %v\n", err)
 		}
 	}()
 
@@ -67,7 +86,11 @@ func runReport(cmd *cobra.Command, args []string) error {
 	allRecords := append(historicalRecords, currentRecords...)
 
 	// Generate and display report
-	displayReport(allRecords, startTime, endTime)
+	rows, totalDuration := aggregateUsage(allRecords, reportGroupBy)
+	if reportFormat == "csv" {
+		return writeReportCSV(os.Stdout, rows, totalDuration, reportGroupBy)
+	}
+	displayReport(os.Stdout, rows, totalDuration, len(allRecords), startTime, endTime, reportDays, reportGroupBy)
 
 	return nil
 }
@@ -99,70 +122,130 @@ func getCurrentUsageRecords(statuses []gpu.GPUStatusInfo, now time.Time) []*type
 	return records
 }
 
-func displayReport(records []*types.UsageRecord, startTime, endTime time.Time) {
-	// Aggregate usage by user
-	userUsage := make(map[string]float64)
-	userGPUHours := make(map[string]float64)
-	userRunCount := make(map[string]int)
-	userManualCount := make(map[string]int)
+// reportRow holds aggregated usage for a single user or GPU
+type reportRow struct {
+	Key         string
+	Duration    float64 // seconds
+	RunCount    int
+	ManualCount int
+}
 
+// aggregateUsage sums usage records by user or GPU, returning rows sorted by
+// usage (highest first) along with the total duration in seconds
+func aggregateUsage(records []*types.UsageRecord, groupBy string) ([]reportRow, float64) {
+	rowsByKey := make(map[string]*reportRow)
 	var totalDuration float64
 
 	for _, record := range records {
-		userUsage[record.User] += record.Duration
-		userGPUHours[record.User] += record.Duration / 3600.0
+		key := record.User
+		if groupBy == "gpu" {
+			key = strconv.Itoa(record.GPUID)
+		}
+
+		row, ok := rowsByKey[key]
+		if !ok {
+			row = &reportRow{Key: key}
+			rowsByKey[key] = row
+		}
+
+		row.Duration += record.Duration
 		totalDuration += record.Duration
 
 		if record.ReservationType == types.ReservationTypeRun {
-			userRunCount[record.User]++
+			row.RunCount++
 		} else {
-			userManualCount[record.User]++
+			row.ManualCount++
 		}
 	}
 
-	// Sort users by usage
-	var users []string
-	for user := range userUsage {
-		users = append(users, user)
+	rows := make([]reportRow, 0, len(rowsByKey))
+	for _, row := range rowsByKey {
+		rows = append(rows, *row)
 	}
-	sort.Slice(users, func(i, j int) bool {
-		return userUsage[users[i]] > userUsage[users[j]]
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Duration != rows[j].Duration {
+			return rows[i].Duration > rows[j].Duration
+		}
+		return rows[i].Key < rows[j].Key
 	})
 
+	return rows, totalDuration
+}
+
+// usagePercentage returns the share of the total, guarding against an empty report
+func usagePercentage(duration, totalDuration float64) float64 {
+	if totalDuration == 0 {
+		return 0
+	}
+	return (duration / totalDuration) * 100
+}
+
+func writeReportCSV(w io.Writer, rows []reportRow, totalDuration float64, groupBy string) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{groupBy, "gpu_hours", "percentage", "run", "manual"}); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{
+			row.Key,
+			strconv.FormatFloat(row.Duration/3600.0, 'f', 2, 64),
+			strconv.FormatFloat(usagePercentage(row.Duration, totalDuration), 'f', 1, 64),
+			strconv.Itoa(row.RunCount),
+			strconv.Itoa(row.ManualCount),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func displayReport(w io.Writer, rows []reportRow, totalDuration float64, totalRecords int, startTime, endTime time.Time, days int, groupBy string) {
+	groupLabel := "User"
+	if groupBy == "gpu" {
+		groupLabel = "GPU"
+	}
+
 	// Display report header
-	fmt.Printf("\n=== GPU Reservation Report ===\n")
-	fmt.Printf("Period: %s to %s (%d days)\n",
+	_, _ = fmt.Fprintf(w, "\n=== GPU Reservation Report ===\n")
+	_, _ = fmt.Fprintf(w, "Period: %s to %s (%d days)\n",
 		startTime.Format("2006-01-02"),
 		endTime.Format("2006-01-02"),
-		reportDays)
-	fmt.Printf("\n")
+		days)
+	_, _ = fmt.Fprintf(w, "\n")
 
-	// Display per-user statistics
-	fmt.Printf("%-20s %15s %15s %10s %10s\n",
-		"User", "GPU Hours", "Percentage", "Run", "Manual")
-	fmt.Printf("%s\n", strings.Repeat("-", 75))
+	// Display per-group statistics
+	_, _ = fmt.Fprintf(w, "%-20s %15s %15s %10s %10s\n",
+		groupLabel, "GPU Hours", "Percentage", "Run", "Manual")
+	_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", 75))
 
 	totalGPUHours := totalDuration / 3600.0
-	for _, user := range users {
-		percentage := (userUsage[user] / totalDuration) * 100
-		fmt.Printf("%-20s %15.2f %14.1f%% %10d %10d\n",
-			user,
-			userGPUHours[user],
-			percentage,
-			userRunCount[user],
-			userManualCount[user])
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%-20s %15.2f %14.1f%% %10d %10d\n",
+			row.Key,
+			row.Duration/3600.0,
+			usagePercentage(row.Duration, totalDuration),
+			row.RunCount,
+			row.ManualCount)
 	}
 
 	// Display summary
-	fmt.Printf("%s\n", strings.Repeat("-", 75))
-	fmt.Printf("%-20s %15.2f %14s %10d %10d\n",
+	_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", 75))
+	_, _ = fmt.Fprintf(w, "%-20s %15.2f %14s %10d %10d\n",
 		"TOTAL",
 		totalGPUHours,
 		"100.0%",
-		len(records),
+		totalRecords,
 		0)
 
-	fmt.Printf("\nTotal reservations: %d\n", len(records))
-	fmt.Printf("Unique users: %d\n", len(users))
-	fmt.Printf("\n")
+	_, _ = fmt.Fprintf(w, "\nTotal reservations: %d\n", totalRecords)
+	if groupBy == "gpu" {
+		_, _ = fmt.Fprintf(w, "GPUs used: %d\n", len(rows))
+	} else {
+		_, _ = fmt.Fprintf(w, "Unique users: %d\n", len(rows))
+	}
+	_, _ = fmt.Fprintf(w, "\n")
 }
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/russellb/canhazgpu/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUsageRecords() []*types.UsageRecord {
+	return []*types.UsageRecord{
+		{User: "alice", GPUID: 0, Duration: 7200, ReservationType: types.ReservationTypeRun},
+		{User: "alice", GPUID: 1, Duration: 3600, ReservationType: types.ReservationTypeManual},
+		{User: "bob", GPUID: 1, Duration: 3600, ReservationType: types.ReservationTypeRun},
+	}
+}
+
+func TestAggregateUsage(t *testing.T) {
+	t.Run("Group by user", func(t *testing.T) {
+		rows, total := aggregateUsage(testUsageRecords(), "user")
+
+		assert.Equal(t, 14400.0, total)
+		require.Len(t, rows, 2)
+		assert.Equal(t, reportRow{Key: "alice", Duration: 10800, RunCount: 1, ManualCount: 1}, rows[0])
+		assert.Equal(t, reportRow{Key: "bob", Duration: 3600, RunCount: 1}, rows[1])
+	})
+
+	t.Run("Group by GPU", func(t *testing.T) {
+		rows, total := aggregateUsage(testUsageRecords(), "gpu")
+
+		assert.Equal(t, 14400.0, total)
+		require.Len(t, rows, 2)
+		// Equal usage is ordered by key for stable output
+		assert.Equal(t, reportRow{Key: "0", Duration: 7200, RunCount: 1}, rows[0])
+		assert.Equal(t, reportRow{Key: "1", Duration: 7200, RunCount: 1, ManualCount: 1}, rows[1])
+	})
+
+	t.Run("No records", func(t *testing.T) {
+		rows, total := aggregateUsage(nil, "user")
+
+		assert.Empty(t, rows)
+		assert.Equal(t, 0.0, total)
+	})
+}
+
+func TestWriteReportCSV(t *testing.T) {
+	rows, total := aggregateUsage(testUsageRecords(), "user")
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReportCSV(&buf, rows, total, "user"))
+
+	expected := "user,gpu_hours,percentage,run,manual\n" +
+		"alice,3.00,75.0,1,1\n" +
+		"bob,1.00,25.0,1,0\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteReportCSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeReportCSV(&buf, nil, 0, "gpu"))
+
+	assert.Equal(t, "gpu,gpu_hours,percentage,run,manual\n", buf.String())
+}
+
+func TestDisplayReport(t *testing.T) {
+	startTime := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)
+	records := testUsageRecords()
+
+	t.Run("Group by user", func(t *testing.T) {
+		rows, total := aggregateUsage(records, "user")
+
+		var buf bytes.Buffer
+		displayReport(&buf, rows, total, len(records), startTime, endTime, 7, "user")
+		output := buf.String()
+
+		assert.Contains(t, output, "Period: 2025-06-01 to 2025-06-08 (7 days)")
+		assert.Regexp(t, `User\s+GPU Hours\s+Percentage\s+Run\s+Manual`, output)
+		assert.Regexp(t, `alice\s+3\.00\s+75\.0%\s+1\s+1`, output)
+		assert.Regexp(t, `bob\s+1\.00\s+25\.0%\s+1\s+0`, output)
+		assert.Regexp(t, `TOTAL\s+4\.00\s+100\.0%`, output)
+		assert.Contains(t, output, "Total reservations: 3")
+		assert.Contains(t, output, "Unique users: 2")
+	})
+
+	t.Run("Group by GPU", func(t *testing.T) {
+		rows, total := aggregateUsage(records, "gpu")
+
+		var buf bytes.Buffer
+		displayReport(&buf, rows, total, len(records), startTime, endTime, 7, "gpu")
+		output := buf.String()
+
+		assert.Regexp(t, `GPU\s+GPU Hours\s+Percentage\s+Run\s+Manual`, output)
+		assert.Regexp(t, `\n0\s+2\.00\s+50\.0%\s+1\s+0`, output)
+		assert.Regexp(t, `\n1\s+2\.00\s+50\.0%\s+1\s+1`, output)
+		assert.Contains(t, output, "GPUs used: 2")
+		assert.NotContains(t, output, "Unique users")
+	})
+}
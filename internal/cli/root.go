@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/russellb/canhazgpu/internal/types"
 	"github.com/spf13/cobra"
@@ -23,6 +25,9 @@ to requested GPUs while automatically handling cleanup when jobs complete or cra
 		CompletionOptions: cobra.CompletionOptions{
 			DisableDefaultCmd: true,
 		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return validateRedisSettings()
+		},
 	}
 )
 
@@ -35,6 +40,15 @@ func init() {
 	rootCmd.PersistentFlags().Int("redis-port", 6379, "Redis port")
 	rootCmd.PersistentFlags().Int("redis-db", 0, "Redis database")
 	rootCmd.PersistentFlags().Int("memory-threshold", types.MemoryThresholdMB, "Memory threshold in MB to consider a GPU as 'in use' (default: 1024)")
+	rootCmd.PersistentFlags().Int("redis-pool-size", 0, "Maximum number of Redis connections (0 uses the go-redis default of 10 per CPU)")
+	rootCmd.PersistentFlags().Duration("redis-dial-timeout", types.DefaultRedisDialTimeout, "Timeout for establishing Redis connections (0 uses the go-redis default of 5s)")
+	rootCmd.PersistentFlags().Duration("redis-read-timeout", types.DefaultRedisReadTimeout, "Timeout for Redis socket reads (0 disables the timeout)")
+	rootCmd.PersistentFlags().Duration("redis-write-timeout", types.DefaultRedisWriteTimeout, "Timeout for Redis socket writes (0 disables the timeout)")
+	rootCmd.PersistentFlags().Int("redis-max-retries", types.DefaultRedisMaxRetries, "Maximum number of retries for failed Redis commands (0 disables retries)")
+	rootCmd.PersistentFlags().Bool("redis-tls", false, "Connect to Redis using TLS")
+	rootCmd.PersistentFlags().String("redis-tls-ca-cert", "", "CA certificate file used to verify the Redis server (default: system roots)")
+	rootCmd.PersistentFlags().String("redis-tls-cert", "", "Client certificate file for Redis mutual TLS")
+	rootCmd.PersistentFlags().String("redis-tls-key", "", "Client key file for Redis mutual TLS")
 
 	if err := viper.BindPFlag("redis.host", rootCmd.PersistentFlags().Lookup("redis-host")); err != nil {
 		panic(fmt.Sprintf("Failed to bind redis-host flag: %v", err))
@@ -48,12 +62,24 @@ func init() {
 	if err := viper.BindPFlag("memory.threshold", rootCmd.PersistentFlags().Lookup("memory-threshold")); err != nil {
 		panic(fmt.Sprintf("Failed to bind memory-threshold flag: %v", err))
 	}
+	for _, name := range []string{
+		"pool-size", "dial-timeout", "read-timeout", "write-timeout", "max-retries",
+		"tls", "tls-ca-cert", "tls-cert", "tls-key",
+	} {
+		if err := viper.BindPFlag("redis."+name, rootCmd.PersistentFlags().Lookup("redis-"+name)); err != nil {
+			panic(fmt.Sprintf("Failed to bind redis-%s flag: %v", name, err))
+		}
+	}
 
 	// Set defaults
 	viper.SetDefault("redis.host", "localhost")
 	viper.SetDefault("redis.port", 6379)
 	viper.SetDefault("redis.db", 0)
 	viper.SetDefault("memory.threshold", types.MemoryThresholdMB)
+	viper.SetDefault("redis.dial-timeout", types.DefaultRedisDialTimeout)
+	viper.SetDefault("redis.read-timeout", types.DefaultRedisReadTimeout)
+	viper.SetDefault("redis.write-timeout", types.DefaultRedisWriteTimeout)
+	viper.SetDefault("redis.max-retries", types.DefaultRedisMaxRetries)
 }
 
 func initConfig() {
@@ -75,7 +101,9 @@ func initConfig() {
 	}
 
 	// Enable reading from environment variables
+	// Map keys like "redis.pool-size" to CANHAZGPU_REDIS_POOL_SIZE
 	viper.SetEnvPrefix("CANHAZGPU")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	viper.AutomaticEnv()
 
 	// If a config file is found, read it in
@@ -91,7 +119,46 @@ func initConfig() {
 		RedisPort:       viper.GetInt("redis.port"),
 		RedisDB:         viper.GetInt("redis.db"),
 		MemoryThreshold: viper.GetInt("memory.threshold"),
+
+		RedisPoolSize:     viper.GetInt("redis.pool-size"),
+		RedisDialTimeout:  viper.GetDuration("redis.dial-timeout"),
+		RedisReadTimeout:  redisDisabledIfZero(viper.GetDuration("redis.read-timeout")),
+		RedisWriteTimeout: redisDisabledIfZero(viper.GetDuration("redis.write-timeout")),
+		RedisMaxRetries:   redisDisabledIfZero(viper.GetInt("redis.max-retries")),
+
+		RedisTLS:       viper.GetBool("redis.tls"),
+		RedisTLSCACert: viper.GetString("redis.tls-ca-cert"),
+		RedisTLSCert:   viper.GetString("redis.tls-cert"),
+		RedisTLSKey:    viper.GetString("redis.tls-key"),
+	}
+}
+
+// validateRedisSettings rejects negative Redis tuning values. go-redis does
+// not check them: a negative pool size panics, a negative retry count skips
+// sending commands entirely, and negative timeouts fail every connection.
+// 0 is the only supported way to request "disabled" or "use the default".
+func validateRedisSettings() error {
+	if poolSize := viper.GetInt("redis.pool-size"); poolSize < 0 {
+		return fmt.Errorf("invalid redis pool-size %d: must be 0 or greater", poolSize)
+	}
+	if maxRetries := viper.GetInt("redis.max-retries"); maxRetries < 0 {
+		return fmt.Errorf("invalid redis max-retries %d: must be 0 or greater (0 disables retries)", maxRetries)
+	}
+	for _, name := range []string{"dial-timeout", "read-timeout", "write-timeout"} {
+		if timeout := viper.GetDuration("redis." + name); timeout < 0 {
+			return fmt.Errorf("invalid redis %s %s: must be 0 or greater", name, timeout)
+		}
+	}
+	return nil
+}
+
+// redisDisabledIfZero maps a user-supplied 0 to the go-redis -1 sentinel, so
+// "--redis-max-retries 0" turns retries off instead of selecting the default
+func redisDisabledIfZero[T int | time.Duration](v T) T {
+	if v == 0 {
+		return -1
 	}
+	return v
 }
 
 func Execute(ctx context.Context) error {
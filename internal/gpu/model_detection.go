@@ -117,7 +117,7 @@ func getParentPID(pid int) (int, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("Warning: failed to close file: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to close file: %v\n", err)
 		}
 	}()
 
@@ -39,7 +39,7 @@ func getProcessOwnerFromProc(pid int) (string, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("Warning: failed to close file: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to close file: %v\n", err)
 		}
 	}()
 
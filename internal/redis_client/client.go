@@ -2,9 +2,13 @@ package redis_client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net"
+	"os"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -13,15 +17,70 @@ import (
 
 type Client struct {
 	rdb *redis.Client
+	// initErr records a configuration problem (e.g. an unreadable TLS
+	// certificate) found while building the client. Ping reports it directly,
+	// and every other command fails with it since no connection can be dialed.
+	initErr error
 }
 
 func NewClient(config *types.Config) *Client {
-	rdb := redis.NewClient(&redis.Options{
-		Addr: fmt.Sprintf("%s:%d", config.RedisHost, config.RedisPort),
-		DB:   config.RedisDB,
-	})
+	opts := &redis.Options{
+		Addr:         fmt.Sprintf("%s:%d", config.RedisHost, config.RedisPort),
+		DB:           config.RedisDB,
+		PoolSize:     config.RedisPoolSize,
+		DialTimeout:  config.RedisDialTimeout,
+		ReadTimeout:  config.RedisReadTimeout,
+		WriteTimeout: config.RedisWriteTimeout,
+		MaxRetries:   config.RedisMaxRetries,
+	}
+
+	var initErr error
+	if config.RedisTLS {
+		opts.TLSConfig, initErr = newTLSConfig(config)
+	}
+	if initErr != nil {
+		// Fail closed: never fall back to a plaintext connection when the
+		// requested TLS configuration could not be built
+		opts.Dialer = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return nil, initErr
+		}
+	}
 
-	return &Client{rdb: rdb}
+	return &Client{rdb: redis.NewClient(opts), initErr: initErr}
+}
+
+// newTLSConfig builds the TLS configuration for the Redis connection, loading
+// an optional CA bundle and client certificate for mutual TLS
+func newTLSConfig(config *types.Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: config.RedisHost,
+	}
+
+	if config.RedisTLSCACert != "" {
+		caPEM, err := os.ReadFile(config.RedisTLSCACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Redis CA certificate: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in Redis CA certificate %s", config.RedisTLSCACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.RedisTLSCert != "" || config.RedisTLSKey != "" {
+		if config.RedisTLSCert == "" || config.RedisTLSKey == "" {
+			return nil, fmt.Errorf("both a Redis TLS client certificate and key must be specified")
+		}
+		cert, err := tls.LoadX509KeyPair(config.RedisTLSCert, config.RedisTLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load Redis TLS client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
 
 func (c *Client) Close() error {
@@ -29,6 +88,9 @@ func (c *Client) Close() error {
 }
 
 func (c *Client) Ping(ctx context.Context) error {
+	if c.initErr != nil {
+		return c.initErr
+	}
 	return c.rdb.Ping(ctx).Err()
 }
 
@@ -527,7 +589,7 @@ func (c *Client) RecordUsageHistory(ctx context.Context, record *types.UsageReco
 	// Set expiration on sorted set (90 days) if not already set
 	if err := c.rdb.Expire(ctx, sortedSetKey, 90*24*time.Hour).Err(); err != nil {
 		// Log warning but don't fail - expiration might already be set
-		fmt.Printf("Warning: failed to set expiration on usage history: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Warning: failed to set expiration on usage history: %v\n", err)
 	}
 
 	return nil
@@ -577,7 +639,7 @@ func (c *Client) GetUsageHistory(ctx context.Context, startTime, endTime time.Ti
 	if len(oldRecords) > 0 {
 		if err := c.migrateOldUsageRecords(ctx, oldRecords); err != nil {
 			// Log warning but still return the old records
-			fmt.Printf("Warning: failed to migrate old usage records: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to migrate old usage records: %v\n", err)
 		}
 	}
 
@@ -641,7 +703,7 @@ func (c *Client) migrateOldUsageRecords(ctx context.Context, records []*types.Us
 		// Set expiration on sorted set (90 days)
 		if err := c.rdb.Expire(ctx, sortedSetKey, 90*24*time.Hour).Err(); err != nil {
 			// Log warning but don't fail
-			fmt.Printf("Warning: failed to set expiration on usage history: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to set expiration on usage history: %v\n", err)
 		}
 	}
 
@@ -2,8 +2,17 @@ package redis_client
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -360,6 +369,155 @@ func TestClient_NewClient(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestClient_NewClient_ConnectionOptions(t *testing.T) {
+	config := &types.Config{
+		RedisHost:         "localhost",
+		RedisPort:         6379,
+		RedisDB:           15,
+		RedisPoolSize:     4,
+		RedisDialTimeout:  2 * time.Second,
+		RedisReadTimeout:  1 * time.Second,
+		RedisWriteTimeout: 1500 * time.Millisecond,
+		RedisMaxRetries:   1,
+	}
+
+	client := NewClient(config)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	opts := client.rdb.Options()
+	assert.Equal(t, 4, opts.PoolSize)
+	assert.Equal(t, 2*time.Second, opts.DialTimeout)
+	assert.Equal(t, 1*time.Second, opts.ReadTimeout)
+	assert.Equal(t, 1500*time.Millisecond, opts.WriteTimeout)
+	assert.Equal(t, 1, opts.MaxRetries)
+	assert.Nil(t, opts.TLSConfig)
+	assert.NoError(t, client.initErr)
+}
+
+func TestClient_NewClient_DisabledRetriesAndTimeouts(t *testing.T) {
+	client := NewClient(&types.Config{
+		RedisHost:         "localhost",
+		RedisPort:         6379,
+		RedisReadTimeout:  -1,
+		RedisWriteTimeout: -1,
+		RedisMaxRetries:   -1,
+	})
+	defer func() {
+		_ = client.Close()
+	}()
+
+	// go-redis resolves the -1 sentinel to "disabled" (zero) internally
+	opts := client.rdb.Options()
+	assert.Equal(t, time.Duration(0), opts.ReadTimeout)
+	assert.Equal(t, time.Duration(0), opts.WriteTimeout)
+	assert.Equal(t, 0, opts.MaxRetries)
+}
+
+func TestClient_NewClient_TLS(t *testing.T) {
+	t.Run("TLS with system roots", func(t *testing.T) {
+		client := NewClient(&types.Config{
+			RedisHost: "redis.example.com",
+			RedisPort: 6380,
+			RedisTLS:  true,
+		})
+		defer func() {
+			_ = client.Close()
+		}()
+
+		require.NoError(t, client.initErr)
+		tlsConfig := client.rdb.Options().TLSConfig
+		require.NotNil(t, tlsConfig)
+		assert.Equal(t, "redis.example.com", tlsConfig.ServerName)
+		assert.Nil(t, tlsConfig.RootCAs)
+		assert.Empty(t, tlsConfig.Certificates)
+	})
+
+	t.Run("TLS with CA bundle and client certificate", func(t *testing.T) {
+		caPath, certPath, keyPath := writeTestCertificates(t)
+
+		client := NewClient(&types.Config{
+			RedisHost:      "localhost",
+			RedisPort:      6380,
+			RedisTLS:       true,
+			RedisTLSCACert: caPath,
+			RedisTLSCert:   certPath,
+			RedisTLSKey:    keyPath,
+		})
+		defer func() {
+			_ = client.Close()
+		}()
+
+		require.NoError(t, client.initErr)
+		tlsConfig := client.rdb.Options().TLSConfig
+		require.NotNil(t, tlsConfig)
+		require.NotNil(t, tlsConfig.RootCAs)
+		require.Len(t, tlsConfig.Certificates, 1)
+
+		// The leaf must verify against the loaded CA pool
+		leaf, err := x509.ParseCertificate(tlsConfig.Certificates[0].Certificate[0])
+		require.NoError(t, err)
+		assert.Equal(t, "canhazgpu-client", leaf.Subject.CommonName)
+		_, err = leaf.Verify(x509.VerifyOptions{
+			Roots:     tlsConfig.RootCAs,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Missing CA certificate is reported by Ping", func(t *testing.T) {
+		client := NewClient(&types.Config{
+			RedisHost:      "localhost",
+			RedisPort:      6379,
+			RedisTLS:       true,
+			RedisTLSCACert: "/nonexistent/ca.pem",
+		})
+		defer func() {
+			_ = client.Close()
+		}()
+
+		err := client.Ping(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read Redis CA certificate")
+
+		// Commands that skip Ping must not fall back to plaintext
+		_, err = client.GetGPUCount(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read Redis CA certificate")
+	})
+
+	t.Run("Client certificate without key", func(t *testing.T) {
+		client := NewClient(&types.Config{
+			RedisHost:    "localhost",
+			RedisPort:    6379,
+			RedisTLS:     true,
+			RedisTLSCert: "/nonexistent/client.pem",
+		})
+		defer func() {
+			_ = client.Close()
+		}()
+
+		err := client.Ping(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "both a Redis TLS client certificate and key")
+	})
+
+	t.Run("TLS settings ignored when TLS is disabled", func(t *testing.T) {
+		client := NewClient(&types.Config{
+			RedisHost:      "localhost",
+			RedisPort:      6379,
+			RedisTLSCACert: "/nonexistent/ca.pem",
+		})
+		defer func() {
+			_ = client.Close()
+		}()
+
+		assert.NoError(t, client.initErr)
+		assert.Nil(t, client.rdb.Options().TLSConfig)
+	})
+}
+
 func TestClient_AtomicReserveSpecificGPUs(t *testing.T) {
 	client := setupTestRedis(t)
 	ctx := context.Background()
@@ -957,3 +1115,53 @@ func TestClient_ProviderExplicitSet(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "nvidia", provider)
 }
+
+// writeTestCertificates writes a self-signed CA and a client certificate and
+// key signed by it to a temporary directory, returning their paths
+func writeTestCertificates(t *testing.T) (caPath, certPath, keyPath string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	writePEM := func(name, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		require.NoError(t, os.WriteFile(path, data, 0600))
+		return path
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "canhazgpu-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "canhazgpu-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caCert, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+	leafKeyDER, err := x509.MarshalECPrivateKey(leafKey)
+	require.NoError(t, err)
+
+	caPath = writePEM("ca.pem", "CERTIFICATE", caDER)
+	certPath = writePEM("client.pem", "CERTIFICATE", leafDER)
+	keyPath = writePEM("client.key", "EC PRIVATE KEY", leafKeyDER)
+	return caPath, certPath, keyPath
+}
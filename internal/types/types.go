@@ -164,6 +164,20 @@ type Config struct {
 	RedisPort       int
 	RedisDB         int
 	MemoryThreshold int
+
+	// Redis connection tuning, passed to go-redis as-is: zero selects the
+	// library default, and -1 disables retries or the read/write timeouts
+	RedisPoolSize     int
+	RedisDialTimeout  time.Duration
+	RedisReadTimeout  time.Duration
+	RedisWriteTimeout time.Duration
+	RedisMaxRetries   int
+
+	// Redis TLS settings; the certificate paths are only used when RedisTLS is set
+	RedisTLS       bool
+	RedisTLSCACert string
+	RedisTLSCert   string
+	RedisTLSKey    string
 }
 
 // Constants
@@ -183,4 +197,9 @@ const (
 	MaxLockRetries    = 5
 
 	MemoryThresholdMB = 1024
+
+	DefaultRedisDialTimeout  = 5 * time.Second
+	DefaultRedisReadTimeout  = 3 * time.Second
+	DefaultRedisWriteTimeout = 3 * time.Second
+	DefaultRedisMaxRetries   = 3
 )